@@ -0,0 +1,228 @@
+package wiremock
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+)
+
+// Client is HTTP client for WireMock admin API.
+type Client struct {
+	url string
+}
+
+// NewClient returns *Client.
+func NewClient(url string) *Client {
+	return &Client{url: url}
+}
+
+// LoggedRequest is a request WireMock has recorded in its request journal.
+type LoggedRequest struct {
+	URL        string            `json:"url"`
+	Method     string            `json:"method"`
+	Headers    map[string]string `json:"headers"`
+	Body       string            `json:"body"`
+	LoggedDate int64             `json:"loggedDate"`
+}
+
+// StubFor creates a new stub mapping.
+func (c *Client) StubFor(stubRule *StubRule) error {
+	requestBody, err := json.Marshal(stubRule)
+	if err != nil {
+		return fmt.Errorf("build stub request error: %s", err.Error())
+	}
+
+	res, err := http.Post(fmt.Sprintf("%s/__admin/mappings", c.url), "application/json", bytes.NewReader(requestBody))
+	if err != nil {
+		return fmt.Errorf("stub request error: %s", err.Error())
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusCreated {
+		result, _ := ioutil.ReadAll(res.Body)
+		return fmt.Errorf("stub registration failed with status code %d, response: %s", res.StatusCode, string(result))
+	}
+
+	return nil
+}
+
+// GetCountRequests returns the number of requests WireMock has received matching the given pattern.
+func (c *Client) GetCountRequests(r *Request) (int64, error) {
+	requestBody, err := json.Marshal(r)
+	if err != nil {
+		return 0, fmt.Errorf("build count requests error: %s", err.Error())
+	}
+
+	res, err := http.Post(fmt.Sprintf("%s/__admin/requests/count", c.url), "application/json", bytes.NewReader(requestBody))
+	if err != nil {
+		return 0, fmt.Errorf("count requests error: %s", err.Error())
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		result, _ := ioutil.ReadAll(res.Body)
+		return 0, fmt.Errorf("count requests failed with status code %d, response: %s", res.StatusCode, string(result))
+	}
+
+	var response struct {
+		Count int64 `json:"count"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return 0, fmt.Errorf("parse count requests response error: %s", err.Error())
+	}
+
+	return response.Count, nil
+}
+
+// Verify returns true if WireMock has received exactly expectedCount requests matching the given pattern.
+func (c *Client) Verify(r *Request, expectedCount int64) (bool, error) {
+	count, err := c.GetCountRequests(r)
+	if err != nil {
+		return false, err
+	}
+
+	return count == expectedCount, nil
+}
+
+// FindRequests returns the requests WireMock has received matching the given pattern.
+func (c *Client) FindRequests(r *Request) ([]LoggedRequest, error) {
+	requestBody, err := json.Marshal(r)
+	if err != nil {
+		return nil, fmt.Errorf("build find requests error: %s", err.Error())
+	}
+
+	res, err := http.Post(fmt.Sprintf("%s/__admin/requests/find", c.url), "application/json", bytes.NewReader(requestBody))
+	if err != nil {
+		return nil, fmt.Errorf("find requests error: %s", err.Error())
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		result, _ := ioutil.ReadAll(res.Body)
+		return nil, fmt.Errorf("find requests failed with status code %d, response: %s", res.StatusCode, string(result))
+	}
+
+	var response struct {
+		Requests []LoggedRequest `json:"requests"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("parse find requests response error: %s", err.Error())
+	}
+
+	return response.Requests, nil
+}
+
+// ImportStubs posts a batch of stub mappings to WireMock in a single request.
+func (c *Client) ImportStubs(stubs ...*StubRule) error {
+	requestBody, err := json.Marshal(struct {
+		Mappings []*StubRule `json:"mappings"`
+	}{Mappings: stubs})
+	if err != nil {
+		return fmt.Errorf("build import stubs request error: %s", err.Error())
+	}
+
+	return c.importMappings(bytes.NewReader(requestBody))
+}
+
+// ImportStubsFromFile reads a WireMock mappings JSON file (either a single mapping or the
+// {"mappings": [...]} envelope) and imports it.
+func (c *Client) ImportStubsFromFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open stub mappings file error: %s", err.Error())
+	}
+	defer file.Close()
+
+	return c.ImportStubsFromReader(file)
+}
+
+// ImportStubsFromReader reads a WireMock mappings JSON document (either a single mapping or the
+// {"mappings": [...]} envelope) and imports it.
+func (c *Client) ImportStubsFromReader(reader io.Reader) error {
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return fmt.Errorf("read stub mappings error: %s", err.Error())
+	}
+
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return fmt.Errorf("parse stub mappings error: %s", err.Error())
+	}
+
+	if _, ok := envelope["mappings"]; ok {
+		return c.importMappings(bytes.NewReader(data))
+	}
+
+	wrapped, err := json.Marshal(struct {
+		Mappings []json.RawMessage `json:"mappings"`
+	}{Mappings: []json.RawMessage{data}})
+	if err != nil {
+		return fmt.Errorf("build import stubs request error: %s", err.Error())
+	}
+
+	return c.importMappings(bytes.NewReader(wrapped))
+}
+
+// ExportStubs writes all currently registered stub mappings as WireMock mappings JSON.
+func (c *Client) ExportStubs(w io.Writer) error {
+	res, err := http.Get(fmt.Sprintf("%s/__admin/mappings", c.url))
+	if err != nil {
+		return fmt.Errorf("export stubs error: %s", err.Error())
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		result, _ := ioutil.ReadAll(res.Body)
+		return fmt.Errorf("export stubs failed with status code %d, response: %s", res.StatusCode, string(result))
+	}
+
+	if _, err := io.Copy(w, res.Body); err != nil {
+		return fmt.Errorf("write exported stubs error: %s", err.Error())
+	}
+
+	return nil
+}
+
+func (c *Client) importMappings(body io.Reader) error {
+	res, err := http.Post(fmt.Sprintf("%s/__admin/mappings/import", c.url), "application/json", body)
+	if err != nil {
+		return fmt.Errorf("import stubs error: %s", err.Error())
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		result, _ := ioutil.ReadAll(res.Body)
+		return fmt.Errorf("import stubs failed with status code %d, response: %s", res.StatusCode, string(result))
+	}
+
+	return nil
+}
+
+// Reset restores stubs and the request journal to the default state, removing all but the default stub mappings.
+func (c *Client) Reset() error {
+	return c.post("/__admin/reset")
+}
+
+// ResetAllScenarios resets the state of all scenarios.
+func (c *Client) ResetAllScenarios() error {
+	return c.post("/__admin/scenarios/reset")
+}
+
+func (c *Client) post(path string) error {
+	res, err := http.Post(fmt.Sprintf("%s%s", c.url, path), "application/json", nil)
+	if err != nil {
+		return fmt.Errorf("request error: %s", err.Error())
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		result, _ := ioutil.ReadAll(res.Body)
+		return fmt.Errorf("request failed with status code %d, response: %s", res.StatusCode, string(result))
+	}
+
+	return nil
+}
@@ -0,0 +1,12 @@
+package wiremock
+
+// Types of connection fault to inject instead of a normal response.
+const (
+	FaultEmptyResponse          Fault = "EMPTY_RESPONSE"
+	FaultMalformedResponseChunk Fault = "MALFORMED_RESPONSE_CHUNK"
+	FaultRandomDataThenClose    Fault = "RANDOM_DATA_THEN_CLOSE"
+	FaultConnectionResetByPeer  Fault = "CONNECTION_RESET_BY_PEER"
+)
+
+// Fault is enum of connection fault types WireMock can simulate.
+type Fault string
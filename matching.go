@@ -11,6 +11,8 @@ const (
 	ParamMatchesJsonPath ParamMatchingStrategy = "matchesJsonPath"
 	ParamAbsent          ParamMatchingStrategy = "absent"
 	ParamDoesNotMatch    ParamMatchingStrategy = "doesNotMatch"
+	ParamAnd             ParamMatchingStrategy = "and"
+	ParamOr              ParamMatchingStrategy = "or"
 )
 
 // Types of url matching.
@@ -154,3 +156,45 @@ func NotMatching(param string) ParamMatcher {
 		value:    param,
 	}
 }
+
+// compositeParamMatcher is a ParamMatcherInterface built out of other ParamMatcherInterface
+// values, combined with "and"/"or"/"doesNotMatch" rather than a single strategy/value pair.
+type compositeParamMatcher struct {
+	strategy ParamMatchingStrategy
+	matchers []ParamMatcherInterface
+}
+
+// Strategy returns ParamMatchingStrategy of compositeParamMatcher.
+func (m compositeParamMatcher) Strategy() ParamMatchingStrategy {
+	return m.strategy
+}
+
+// Value returns an empty string, as compositeParamMatcher has no single value; it is detected
+// by type and serialized specially by matcherToMap instead.
+func (m compositeParamMatcher) Value() string {
+	return ""
+}
+
+// And returns a ParamMatcherInterface that matches when all of the given matchers match.
+func And(matchers ...ParamMatcherInterface) ParamMatcherInterface {
+	return compositeParamMatcher{
+		strategy: ParamAnd,
+		matchers: matchers,
+	}
+}
+
+// Or returns a ParamMatcherInterface that matches when any of the given matchers match.
+func Or(matchers ...ParamMatcherInterface) ParamMatcherInterface {
+	return compositeParamMatcher{
+		strategy: ParamOr,
+		matchers: matchers,
+	}
+}
+
+// Not returns a ParamMatcherInterface that matches when the given matcher does not match.
+func Not(matcher ParamMatcherInterface) ParamMatcherInterface {
+	return compositeParamMatcher{
+		strategy: ParamDoesNotMatch,
+		matchers: []ParamMatcherInterface{matcher},
+	}
+}
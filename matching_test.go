@@ -0,0 +1,51 @@
+package wiremock
+
+import "testing"
+
+func TestCompositeParamMatcherValueDoesNotPanic(t *testing.T) {
+	matchers := []ParamMatcherInterface{
+		And(EqualTo("a"), EqualTo("b")),
+		Or(EqualTo("a"), EqualTo("b")),
+		Not(EqualTo("a")),
+	}
+
+	for _, matcher := range matchers {
+		if got := matcher.Value(); got != "" {
+			t.Errorf("expected empty Value(), got %q", got)
+		}
+	}
+}
+
+func TestMatcherToMapComposite(t *testing.T) {
+	tests := []struct {
+		name    string
+		matcher ParamMatcherInterface
+		key     string
+	}{
+		{"and", And(MatchingJsonPath("$.id"), EqualTo("1")), "and"},
+		{"or", Or(EqualTo("a"), EqualTo("b")), "or"},
+		{"not", Not(EqualToJson(`{"id":0}`)), "doesNotMatch"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, ok := matcherToMap(tt.matcher).(map[string]interface{})
+			if !ok {
+				t.Fatalf("expected map[string]interface{}, got %T", matcherToMap(tt.matcher))
+			}
+			if _, ok := result[tt.key]; !ok {
+				t.Errorf("expected key %q in %v", tt.key, result)
+			}
+		})
+	}
+}
+
+func TestMatcherToMapPlain(t *testing.T) {
+	result, ok := matcherToMap(EqualTo("foo")).(map[ParamMatchingStrategy]string)
+	if !ok {
+		t.Fatalf("expected map[ParamMatchingStrategy]string, got %T", matcherToMap(EqualTo("foo")))
+	}
+	if result[ParamEqualTo] != "foo" {
+		t.Errorf("expected %q, got %q", "foo", result[ParamEqualTo])
+	}
+}
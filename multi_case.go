@@ -0,0 +1,31 @@
+package wiremock
+
+// MultiCaseBuilder lets several response variants share one request method and URL matcher
+// and register together as a single batch of stub mappings.
+type MultiCaseBuilder struct {
+	method     string
+	urlMatcher URLMatcher
+	cases      []*StubRule
+}
+
+// MultiCase returns a new *MultiCaseBuilder for the given method and URL matcher.
+func MultiCase(method string, urlMatcher URLMatcher) *MultiCaseBuilder {
+	return &MultiCaseBuilder{
+		method:     method,
+		urlMatcher: urlMatcher,
+	}
+}
+
+// Case adds a new *StubRule variant sharing the builder's method and URL matcher. The name is
+// for readability at the call site only and is not sent to WireMock. Cases are auto-assigned an
+// increasing priority in the order they are added, so earlier, more specific cases win.
+func (b *MultiCaseBuilder) Case(name string) *StubRule {
+	stubRule := NewStubRule(b.method, b.urlMatcher).AtPriority(int64(len(b.cases) + 1))
+	b.cases = append(b.cases, stubRule)
+	return stubRule
+}
+
+// Register posts every case in this builder to WireMock in a single batch import.
+func (b *MultiCaseBuilder) Register(client *Client) error {
+	return client.ImportStubs(b.cases...)
+}
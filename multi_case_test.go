@@ -0,0 +1,20 @@
+package wiremock
+
+import "testing"
+
+func TestMultiCaseBuilderAssignsIncreasingPriority(t *testing.T) {
+	builder := MultiCase("GET", URLEqualTo("/things"))
+
+	first := builder.Case("first")
+	second := builder.Case("second")
+
+	if first.priority == nil || *first.priority != 1 {
+		t.Errorf("expected first case priority 1, got %v", first.priority)
+	}
+	if second.priority == nil || *second.priority != 2 {
+		t.Errorf("expected second case priority 2, got %v", second.priority)
+	}
+	if len(builder.cases) != 2 {
+		t.Errorf("expected 2 cases, got %d", len(builder.cases))
+	}
+}
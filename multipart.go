@@ -0,0 +1,82 @@
+package wiremock
+
+import "encoding/json"
+
+// Types of multipart matching.
+const (
+	MultipartMatchingAll MultipartMatchingType = "ALL"
+	MultipartMatchingAny MultipartMatchingType = "ANY"
+)
+
+// MultipartMatchingType is enum of how a MultipartPattern's header and body patterns combine.
+type MultipartMatchingType string
+
+// MultipartPattern is structure for defining a match against one part of a multipart request body.
+type MultipartPattern struct {
+	name         *string
+	matchingType MultipartMatchingType
+	headers      map[string]ParamMatcherInterface
+	bodyPatterns []ParamMatcherInterface
+}
+
+// NewMultipartPattern returns a new *MultipartPattern.
+func NewMultipartPattern() *MultipartPattern {
+	return &MultipartPattern{
+		matchingType: MultipartMatchingAny,
+	}
+}
+
+// WithName sets name and returns *MultipartPattern
+func (p *MultipartPattern) WithName(name string) *MultipartPattern {
+	p.name = &name
+	return p
+}
+
+// MatchingType sets matchingType and returns *MultipartPattern
+func (p *MultipartPattern) MatchingType(matchingType MultipartMatchingType) *MultipartPattern {
+	p.matchingType = matchingType
+	return p
+}
+
+// WithHeader adds header to Headers and returns *MultipartPattern
+func (p *MultipartPattern) WithHeader(header string, matcher ParamMatcherInterface) *MultipartPattern {
+	if p.headers == nil {
+		p.headers = map[string]ParamMatcherInterface{}
+	}
+
+	p.headers[header] = matcher
+	return p
+}
+
+// WithBodyPattern adds body pattern and returns *MultipartPattern
+func (p *MultipartPattern) WithBodyPattern(matcher ParamMatcherInterface) *MultipartPattern {
+	p.bodyPatterns = append(p.bodyPatterns, matcher)
+	return p
+}
+
+// MarshalJSON makes json body for a multipart pattern entry.
+func (p *MultipartPattern) MarshalJSON() ([]byte, error) {
+	jsonMultipartPattern := struct {
+		Name         *string                `json:"name,omitempty"`
+		MatchingType MultipartMatchingType  `json:"matchingType"`
+		Headers      map[string]interface{} `json:"headers,omitempty"`
+		BodyPatterns []interface{}          `json:"bodyPatterns,omitempty"`
+	}{
+		Name:         p.name,
+		MatchingType: p.matchingType,
+	}
+
+	if len(p.headers) > 0 {
+		headers := make(map[string]interface{}, len(p.headers))
+		for key, header := range p.headers {
+			headers[key] = matcherToMap(header)
+		}
+		jsonMultipartPattern.Headers = headers
+	}
+
+	if len(p.bodyPatterns) > 0 {
+		jsonMultipartPattern.BodyPatterns = matchersToMaps(p.bodyPatterns)
+	}
+
+	return json.Marshal(jsonMultipartPattern)
+}
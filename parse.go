@@ -0,0 +1,292 @@
+package wiremock
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ParseStubRule parses a single WireMock mapping JSON document into a *StubRule, so mappings
+// exported from this or another WireMock client can be reused programmatically.
+func ParseStubRule(data []byte) (*StubRule, error) {
+	stubRule := &StubRule{}
+	if err := json.Unmarshal(data, stubRule); err != nil {
+		return nil, err
+	}
+
+	return stubRule, nil
+}
+
+// UnmarshalJSON populates a *StubRule from a single WireMock mapping JSON document. Matcher
+// options that this library does not model (e.g. ignoreArrayOrder, caseInsensitive) are dropped;
+// everything else this package can emit via MarshalJSON round-trips, including composite
+// (and/or/doesNotMatch) matchers, multipart patterns, and delay configuration.
+func (s *StubRule) UnmarshalJSON(data []byte) error {
+	var jsonStubRule struct {
+		Priority                      *int64          `json:"priority"`
+		ScenarioName                  *string         `json:"scenarioName"`
+		RequiredScenarioScenarioState *string         `json:"requiredScenarioState"`
+		NewScenarioState              *string         `json:"newScenarioState"`
+		Request                       json.RawMessage `json:"request"`
+		Response                      struct {
+			Body                          string                 `json:"body"`
+			Headers                       map[string]string      `json:"headers"`
+			Status                        int64                  `json:"status"`
+			Fault                         *Fault                 `json:"fault"`
+			FixedDelayMilliseconds        *int64                 `json:"fixedDelayMilliseconds"`
+			DelayDistribution             json.RawMessage        `json:"delayDistribution"`
+			ChunkedDribbleDelay           *chunkedDribbleDelay   `json:"chunkedDribbleDelay"`
+			ProxyBaseURL                  *string                `json:"proxyBaseUrl"`
+			AdditionalProxyRequestHeaders map[string]string      `json:"additionalProxyRequestHeaders"`
+			ProxyURLPrefixToRemove        *string                `json:"proxyUrlPrefixToRemove"`
+			Transformers                  []string               `json:"transformers"`
+			TransformerParameters         map[string]interface{} `json:"transformerParameters"`
+		} `json:"response"`
+	}
+	if err := json.Unmarshal(data, &jsonStubRule); err != nil {
+		return fmt.Errorf("parse stub rule error: %s", err.Error())
+	}
+
+	var jsonRequest jsonRequestDoc
+	if err := json.Unmarshal(jsonStubRule.Request, &jsonRequest); err != nil {
+		return fmt.Errorf("parse stub rule request error: %s", err.Error())
+	}
+
+	stubRule := NewStubRule(jsonRequest.Method, urlMatcherFrom(jsonRequest))
+	for name, raw := range jsonRequest.Headers {
+		matcher, err := paramMatcherFromRaw(raw)
+		if err != nil {
+			return fmt.Errorf("parse stub rule request header %q error: %s", name, err.Error())
+		}
+		stubRule.WithHeader(name, matcher)
+	}
+	for name, raw := range jsonRequest.QueryParameters {
+		matcher, err := paramMatcherFromRaw(raw)
+		if err != nil {
+			return fmt.Errorf("parse stub rule request query parameter %q error: %s", name, err.Error())
+		}
+		stubRule.WithQueryParam(name, matcher)
+	}
+	for name, raw := range jsonRequest.Cookies {
+		matcher, err := paramMatcherFromRaw(raw)
+		if err != nil {
+			return fmt.Errorf("parse stub rule request cookie %q error: %s", name, err.Error())
+		}
+		stubRule.WithCookie(name, matcher)
+	}
+	for _, raw := range jsonRequest.BodyPatterns {
+		matcher, err := paramMatcherFromRaw(raw)
+		if err != nil {
+			return fmt.Errorf("parse stub rule request body pattern error: %s", err.Error())
+		}
+		stubRule.WithBodyPattern(matcher)
+	}
+	for _, raw := range jsonRequest.MultipartPatterns {
+		pattern, err := multipartPatternFromRaw(raw)
+		if err != nil {
+			return fmt.Errorf("parse stub rule request multipart pattern error: %s", err.Error())
+		}
+		stubRule.WithMultipartPattern(pattern)
+	}
+
+	var delay *delayDistribution
+	if len(jsonStubRule.Response.DelayDistribution) > 0 {
+		var err error
+		delay, err = delayDistributionFromRaw(jsonStubRule.Response.DelayDistribution)
+		if err != nil {
+			return fmt.Errorf("parse stub rule response delay distribution error: %s", err.Error())
+		}
+	}
+
+	stubRule.priority = jsonStubRule.Priority
+	stubRule.scenarioName = jsonStubRule.ScenarioName
+	stubRule.requiredScenarioState = jsonStubRule.RequiredScenarioScenarioState
+	stubRule.newScenarioState = jsonStubRule.NewScenarioState
+	stubRule.response.body = jsonStubRule.Response.Body
+	stubRule.response.headers = jsonStubRule.Response.Headers
+	stubRule.response.status = jsonStubRule.Response.Status
+	stubRule.response.fault = jsonStubRule.Response.Fault
+	stubRule.response.fixedDelayMilliseconds = jsonStubRule.Response.FixedDelayMilliseconds
+	stubRule.response.delayDistribution = delay
+	stubRule.response.chunkedDribbleDelay = jsonStubRule.Response.ChunkedDribbleDelay
+	stubRule.response.proxyBaseURL = jsonStubRule.Response.ProxyBaseURL
+	stubRule.response.additionalProxyRequestHeaders = jsonStubRule.Response.AdditionalProxyRequestHeaders
+	stubRule.response.proxyURLPrefixToRemove = jsonStubRule.Response.ProxyURLPrefixToRemove
+	stubRule.response.transformers = jsonStubRule.Response.Transformers
+	stubRule.response.transformerParameters = jsonStubRule.Response.TransformerParameters
+
+	*s = *stubRule
+	return nil
+}
+
+// jsonRequestDoc is the decoded shape of the "request" object inside a WireMock mapping JSON
+// document. Matcher values are kept as json.RawMessage since they may be a flat {strategy: value}
+// object (a plain matcher) or a nested object ("and"/"or"/"doesNotMatch"), and may carry sibling option keys
+// (ignoreArrayOrder, caseInsensitive, ...) that this package does not model.
+type jsonRequestDoc struct {
+	Method            string                     `json:"method"`
+	URL               string                     `json:"url"`
+	URLPath           string                     `json:"urlPath"`
+	URLPathPattern    string                     `json:"urlPathPattern"`
+	URLPattern        string                     `json:"urlPattern"`
+	Headers           map[string]json.RawMessage `json:"headers"`
+	QueryParameters   map[string]json.RawMessage `json:"queryParameters"`
+	Cookies           map[string]json.RawMessage `json:"cookies"`
+	BodyPatterns      []json.RawMessage          `json:"bodyPatterns"`
+	MultipartPatterns []json.RawMessage          `json:"multipartPatterns"`
+}
+
+func urlMatcherFrom(jsonRequest jsonRequestDoc) URLMatcher {
+	switch {
+	case jsonRequest.URL != "":
+		return URLEqualTo(jsonRequest.URL)
+	case jsonRequest.URLPath != "":
+		return URLPathEqualTo(jsonRequest.URLPath)
+	case jsonRequest.URLPathPattern != "":
+		return URLPathMatching(jsonRequest.URLPathPattern)
+	default:
+		return URLMatching(jsonRequest.URLPattern)
+	}
+}
+
+// paramMatcherFromRaw decodes a single matcher entry, handling both the flat {strategy: value}
+// shape (sibling option keys such as ignoreArrayOrder are dropped) and the composite "and"/"or"/
+// "doesNotMatch" shapes produced by And/Or/Not.
+func paramMatcherFromRaw(raw json.RawMessage) (ParamMatcherInterface, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, fmt.Errorf("parse matcher error: %s", err.Error())
+	}
+
+	if andRaw, ok := fields["and"]; ok {
+		matchers, err := paramMatchersFromRawArray(andRaw)
+		if err != nil {
+			return nil, err
+		}
+		return And(matchers...), nil
+	}
+	if orRaw, ok := fields["or"]; ok {
+		matchers, err := paramMatchersFromRawArray(orRaw)
+		if err != nil {
+			return nil, err
+		}
+		return Or(matchers...), nil
+	}
+	if notRaw, ok := fields["doesNotMatch"]; ok {
+		var flatValue string
+		if err := json.Unmarshal(notRaw, &flatValue); err == nil {
+			return ParamMatcher{strategy: ParamDoesNotMatch, value: flatValue}, nil
+		}
+		inner, err := paramMatcherFromRaw(notRaw)
+		if err != nil {
+			return nil, err
+		}
+		return Not(inner), nil
+	}
+
+	for key, value := range fields {
+		strategy := ParamMatchingStrategy(key)
+		if !isParamMatchingStrategy(strategy) {
+			continue
+		}
+		var flatValue string
+		if err := json.Unmarshal(value, &flatValue); err != nil {
+			continue
+		}
+		return ParamMatcher{strategy: strategy, value: flatValue}, nil
+	}
+
+	return ParamMatcher{}, nil
+}
+
+func paramMatchersFromRawArray(raw json.RawMessage) ([]ParamMatcherInterface, error) {
+	var rawMatchers []json.RawMessage
+	if err := json.Unmarshal(raw, &rawMatchers); err != nil {
+		return nil, fmt.Errorf("parse composite matcher error: %s", err.Error())
+	}
+
+	matchers := make([]ParamMatcherInterface, len(rawMatchers))
+	for i, rawMatcher := range rawMatchers {
+		matcher, err := paramMatcherFromRaw(rawMatcher)
+		if err != nil {
+			return nil, err
+		}
+		matchers[i] = matcher
+	}
+
+	return matchers, nil
+}
+
+// isParamMatchingStrategy reports whether strategy is one of the known plain matching strategies.
+func isParamMatchingStrategy(strategy ParamMatchingStrategy) bool {
+	switch strategy {
+	case ParamEqualTo, ParamMatches, ParamContains, ParamEqualToXml, ParamEqualToJson,
+		ParamMatchesXPath, ParamMatchesJsonPath, ParamAbsent, ParamDoesNotMatch:
+		return true
+	default:
+		return false
+	}
+}
+
+// jsonMultipartPatternDoc is the decoded shape of a multipartPatterns entry.
+type jsonMultipartPatternDoc struct {
+	Name         *string                    `json:"name"`
+	MatchingType MultipartMatchingType      `json:"matchingType"`
+	Headers      map[string]json.RawMessage `json:"headers"`
+	BodyPatterns []json.RawMessage          `json:"bodyPatterns"`
+}
+
+func multipartPatternFromRaw(raw json.RawMessage) (*MultipartPattern, error) {
+	var doc jsonMultipartPatternDoc
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("parse multipart pattern error: %s", err.Error())
+	}
+
+	pattern := NewMultipartPattern()
+	if doc.MatchingType != "" {
+		pattern.MatchingType(doc.MatchingType)
+	}
+	if doc.Name != nil {
+		pattern.WithName(*doc.Name)
+	}
+	for name, headerRaw := range doc.Headers {
+		matcher, err := paramMatcherFromRaw(headerRaw)
+		if err != nil {
+			return nil, fmt.Errorf("parse multipart pattern header %q error: %s", name, err.Error())
+		}
+		pattern.WithHeader(name, matcher)
+	}
+	for _, bodyRaw := range doc.BodyPatterns {
+		matcher, err := paramMatcherFromRaw(bodyRaw)
+		if err != nil {
+			return nil, err
+		}
+		pattern.WithBodyPattern(matcher)
+	}
+
+	return pattern, nil
+}
+
+// jsonDelayDistributionDoc is the decoded shape of a response.delayDistribution object, covering
+// both the "uniform" (lower/upper) and "lognormal" (median/sigma) variants.
+type jsonDelayDistributionDoc struct {
+	Type   string  `json:"type"`
+	Median float64 `json:"median"`
+	Sigma  float64 `json:"sigma"`
+	Lower  int64   `json:"lower"`
+	Upper  int64   `json:"upper"`
+}
+
+func delayDistributionFromRaw(raw json.RawMessage) (*delayDistribution, error) {
+	var doc jsonDelayDistributionDoc
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("parse delay distribution error: %s", err.Error())
+	}
+
+	return &delayDistribution{
+		distributionType: doc.Type,
+		median:           doc.Median,
+		sigma:            doc.Sigma,
+		lower:            doc.Lower,
+		upper:            doc.Upper,
+	}, nil
+}
@@ -0,0 +1,171 @@
+package wiremock
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func marshalThenParse(t *testing.T, stubRule *StubRule) *StubRule {
+	t.Helper()
+
+	data, err := json.Marshal(stubRule)
+	if err != nil {
+		t.Fatalf("marshal error: %s", err)
+	}
+
+	parsed, err := ParseStubRule(data)
+	if err != nil {
+		t.Fatalf("ParseStubRule error: %s, data: %s", err, data)
+	}
+
+	return parsed
+}
+
+func TestParseStubRuleCompositeMatchers(t *testing.T) {
+	tests := []struct {
+		name    string
+		matcher ParamMatcherInterface
+	}{
+		{"and", And(MatchingJsonPath("$.id"), EqualTo("1"))},
+		{"or", Or(EqualTo("a"), EqualTo("b"))},
+		{"not composite", Not(EqualToJson(`{"id":0}`))},
+		{"not flat", NotMatching("a")},
+		{"plain", EqualTo("a")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stubRule := Post(URLEqualTo("/things")).WithQueryParam("id", tt.matcher)
+			parsed := marshalThenParse(t, stubRule)
+
+			reMarshaled, err := json.Marshal(parsed)
+			if err != nil {
+				t.Fatalf("re-marshal error: %s", err)
+			}
+
+			original, err := json.Marshal(stubRule)
+			if err != nil {
+				t.Fatalf("marshal error: %s", err)
+			}
+
+			if string(reMarshaled) != string(original) {
+				t.Errorf("round-trip mismatch:\n original: %s\n got:      %s", original, reMarshaled)
+			}
+		})
+	}
+}
+
+func TestParseStubRuleIgnoresUnknownMatcherOptions(t *testing.T) {
+	data := []byte(`{
+		"request": {
+			"method": "POST",
+			"url": "/things",
+			"bodyPatterns": [
+				{"equalToJson": "{\"id\":1}", "ignoreArrayOrder": true}
+			]
+		},
+		"response": {"status": 200}
+	}`)
+
+	parsed, err := ParseStubRule(data)
+	if err != nil {
+		t.Fatalf("ParseStubRule error: %s", err)
+	}
+
+	if len(parsed.request.bodyPatterns) != 1 {
+		t.Fatalf("expected 1 body pattern, got %d", len(parsed.request.bodyPatterns))
+	}
+	if got := parsed.request.bodyPatterns[0].Value(); got != `{"id":1}` {
+		t.Errorf("expected body pattern value %q, got %q", `{"id":1}`, got)
+	}
+}
+
+func TestParseStubRuleMultipartPattern(t *testing.T) {
+	stubRule := Post(URLEqualTo("/things")).WithMultipartPattern(
+		NewMultipartPattern().
+			WithName("part").
+			MatchingType(MultipartMatchingAll).
+			WithHeader("Content-Type", EqualTo("text/plain")).
+			WithBodyPattern(Contains("hello")),
+	)
+
+	parsed := marshalThenParse(t, stubRule)
+
+	if len(parsed.request.multipartPatterns) != 1 {
+		t.Fatalf("expected 1 multipart pattern, got %d", len(parsed.request.multipartPatterns))
+	}
+
+	pattern := parsed.request.multipartPatterns[0]
+	if pattern.matchingType != MultipartMatchingAll {
+		t.Errorf("expected matchingType %q, got %q", MultipartMatchingAll, pattern.matchingType)
+	}
+	if pattern.name == nil || *pattern.name != "part" {
+		t.Errorf("expected name %q, got %v", "part", pattern.name)
+	}
+}
+
+func TestParseStubRuleDelayDistribution(t *testing.T) {
+	tests := []struct {
+		name      string
+		stubRule  *StubRule
+		wantType  string
+		wantLower int64
+		wantUpper int64
+	}{
+		{
+			"uniform",
+			Post(URLEqualTo("/things")).WithUniformRandomDelay(100*time.Millisecond, 200*time.Millisecond),
+			"uniform", 100, 200,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed := marshalThenParse(t, tt.stubRule)
+
+			if parsed.response.delayDistribution == nil {
+				t.Fatal("expected delayDistribution to be set")
+			}
+			if parsed.response.delayDistribution.distributionType != tt.wantType {
+				t.Errorf("expected type %q, got %q", tt.wantType, parsed.response.delayDistribution.distributionType)
+			}
+			if parsed.response.delayDistribution.lower != tt.wantLower {
+				t.Errorf("expected lower %d, got %d", tt.wantLower, parsed.response.delayDistribution.lower)
+			}
+			if parsed.response.delayDistribution.upper != tt.wantUpper {
+				t.Errorf("expected upper %d, got %d", tt.wantUpper, parsed.response.delayDistribution.upper)
+			}
+		})
+	}
+
+	t.Run("lognormal", func(t *testing.T) {
+		stubRule := Post(URLEqualTo("/things")).WithLogNormalRandomDelay(50.5, 0.2)
+		parsed := marshalThenParse(t, stubRule)
+
+		if parsed.response.delayDistribution == nil {
+			t.Fatal("expected delayDistribution to be set")
+		}
+		if parsed.response.delayDistribution.distributionType != "lognormal" {
+			t.Errorf("expected type lognormal, got %q", parsed.response.delayDistribution.distributionType)
+		}
+		if parsed.response.delayDistribution.median != 50.5 {
+			t.Errorf("expected median 50.5, got %v", parsed.response.delayDistribution.median)
+		}
+	})
+}
+
+func TestParseStubRuleChunkedDribbleDelay(t *testing.T) {
+	stubRule := Post(URLEqualTo("/things")).WithChunkedDribbleDelay(5, 500*time.Millisecond)
+	parsed := marshalThenParse(t, stubRule)
+
+	if parsed.response.chunkedDribbleDelay == nil {
+		t.Fatal("expected chunkedDribbleDelay to be set")
+	}
+	if parsed.response.chunkedDribbleDelay.NumberOfChunks != 5 {
+		t.Errorf("expected 5 chunks, got %d", parsed.response.chunkedDribbleDelay.NumberOfChunks)
+	}
+	if parsed.response.chunkedDribbleDelay.TotalDuration != 500 {
+		t.Errorf("expected 500ms, got %d", parsed.response.chunkedDribbleDelay.TotalDuration)
+	}
+}
@@ -0,0 +1,74 @@
+package wiremock
+
+import "net/http"
+
+// Request is used to build a RequestPattern matched against requests logged by WireMock for verification.
+type Request struct {
+	request
+}
+
+// NewRequest returns a new *Request.
+func NewRequest(method string, urlMatcher URLMatcher) *Request {
+	return &Request{
+		request: request{
+			urlMatcher: urlMatcher,
+			method:     method,
+		},
+	}
+}
+
+// WithQueryParam adds query param and returns *Request
+func (r *Request) WithQueryParam(param string, matcher ParamMatcherInterface) *Request {
+	if r.request.queryParams == nil {
+		r.request.queryParams = map[string]ParamMatcherInterface{}
+	}
+
+	r.request.queryParams[param] = matcher
+	return r
+}
+
+// WithHeader adds header to Headers and returns *Request
+func (r *Request) WithHeader(header string, matcher ParamMatcherInterface) *Request {
+	if r.request.headers == nil {
+		r.request.headers = map[string]ParamMatcherInterface{}
+	}
+
+	r.request.headers[header] = matcher
+	return r
+}
+
+// WithCookie adds cookie and returns *Request
+func (r *Request) WithCookie(cookie string, matcher ParamMatcherInterface) *Request {
+	if r.request.cookies == nil {
+		r.request.cookies = map[string]ParamMatcherInterface{}
+	}
+
+	r.request.cookies[cookie] = matcher
+	return r
+}
+
+// WithBodyPattern adds body pattern and returns *Request
+func (r *Request) WithBodyPattern(matcher ParamMatcherInterface) *Request {
+	r.request.bodyPatterns = append(r.request.bodyPatterns, matcher)
+	return r
+}
+
+// PostRequest returns *Request for POST method.
+func PostRequest(urlMatchingPair URLMatcher) *Request {
+	return NewRequest(http.MethodPost, urlMatchingPair)
+}
+
+// GetRequest returns *Request for GET method.
+func GetRequest(urlMatchingPair URLMatcher) *Request {
+	return NewRequest(http.MethodGet, urlMatchingPair)
+}
+
+// DeleteRequest returns *Request for DELETE method.
+func DeleteRequest(urlMatchingPair URLMatcher) *Request {
+	return NewRequest(http.MethodDelete, urlMatchingPair)
+}
+
+// PutRequest returns *Request for PUT method.
+func PutRequest(urlMatchingPair URLMatcher) *Request {
+	return NewRequest(http.MethodPut, urlMatchingPair)
+}
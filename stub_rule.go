@@ -3,6 +3,7 @@ package wiremock
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 )
 
 const ScenarioStateStarted = "Started"
@@ -20,18 +21,68 @@ type URLMatcherInterface interface {
 }
 
 type request struct {
-	urlMatcher   URLMatcherInterface
-	method       string
-	headers      map[string]ParamMatcherInterface
-	queryParams  map[string]ParamMatcherInterface
-	cookies      map[string]ParamMatcherInterface
-	bodyPatterns []ParamMatcher
+	urlMatcher        URLMatcherInterface
+	method            string
+	headers           map[string]ParamMatcherInterface
+	queryParams       map[string]ParamMatcherInterface
+	cookies           map[string]ParamMatcherInterface
+	bodyPatterns      []ParamMatcherInterface
+	multipartPatterns []*MultipartPattern
 }
 
 type response struct {
-	body    string
-	headers map[string]string
-	status  int64
+	body                          string
+	headers                       map[string]string
+	status                        int64
+	fault                         *Fault
+	fixedDelayMilliseconds        *int64
+	delayDistribution             *delayDistribution
+	chunkedDribbleDelay           *chunkedDribbleDelay
+	proxyBaseURL                  *string
+	additionalProxyRequestHeaders map[string]string
+	proxyURLPrefixToRemove        *string
+	transformers                  []string
+	transformerParameters         map[string]interface{}
+}
+
+// delayDistribution is response.delayDistribution sub-object for random response delays.
+type delayDistribution struct {
+	distributionType string
+	median           float64
+	sigma            float64
+	lower            int64
+	upper            int64
+}
+
+// MarshalJSON makes json body for delayDistribution sub-object.
+func (d *delayDistribution) MarshalJSON() ([]byte, error) {
+	if d.distributionType == "lognormal" {
+		return json.Marshal(struct {
+			Type   string  `json:"type"`
+			Median float64 `json:"median"`
+			Sigma  float64 `json:"sigma"`
+		}{
+			Type:   d.distributionType,
+			Median: d.median,
+			Sigma:  d.sigma,
+		})
+	}
+
+	return json.Marshal(struct {
+		Type  string `json:"type"`
+		Lower int64  `json:"lower"`
+		Upper int64  `json:"upper"`
+	}{
+		Type:  d.distributionType,
+		Lower: d.lower,
+		Upper: d.upper,
+	})
+}
+
+// chunkedDribbleDelay is response.chunkedDribbleDelay sub-object.
+type chunkedDribbleDelay struct {
+	NumberOfChunks int   `json:"numberOfChunks"`
+	TotalDuration  int64 `json:"totalDuration"`
 }
 
 // StubRule is struct of http request body to WireMock
@@ -88,11 +139,17 @@ func (s *StubRule) WithCookie(cookie string, matcher ParamMatcherInterface) *Stu
 }
 
 // WithBodyPattern adds body pattern and returns *StubRule
-func (s *StubRule) WithBodyPattern(matcher ParamMatcher) *StubRule {
+func (s *StubRule) WithBodyPattern(matcher ParamMatcherInterface) *StubRule {
 	s.request.bodyPatterns = append(s.request.bodyPatterns, matcher)
 	return s
 }
 
+// WithMultipartPattern adds a multipart pattern and returns *StubRule
+func (s *StubRule) WithMultipartPattern(pattern *MultipartPattern) *StubRule {
+	s.request.multipartPatterns = append(s.request.multipartPatterns, pattern)
+	return s
+}
+
 // WillReturn sets response and returns *StubRule
 func (s *StubRule) WillReturn(body string, headers map[string]string, status int64) *StubRule {
 	s.response.body = body
@@ -101,6 +158,91 @@ func (s *StubRule) WillReturn(body string, headers map[string]string, status int
 	return s
 }
 
+// WillReturnFault sets fault and returns *StubRule
+func (s *StubRule) WillReturnFault(fault Fault) *StubRule {
+	s.response.fault = &fault
+	return s
+}
+
+// WithFixedDelay sets fixedDelayMilliseconds and returns *StubRule
+func (s *StubRule) WithFixedDelay(delay time.Duration) *StubRule {
+	milliseconds := delay.Milliseconds()
+	s.response.fixedDelayMilliseconds = &milliseconds
+	return s
+}
+
+// WithUniformRandomDelay sets delayDistribution to a uniform random delay between min and max and returns *StubRule
+func (s *StubRule) WithUniformRandomDelay(minDelay time.Duration, maxDelay time.Duration) *StubRule {
+	s.response.delayDistribution = &delayDistribution{
+		distributionType: "uniform",
+		lower:            minDelay.Milliseconds(),
+		upper:            maxDelay.Milliseconds(),
+	}
+	return s
+}
+
+// WithLogNormalRandomDelay sets delayDistribution to a log-normal random delay and returns *StubRule
+func (s *StubRule) WithLogNormalRandomDelay(median float64, sigma float64) *StubRule {
+	s.response.delayDistribution = &delayDistribution{
+		distributionType: "lognormal",
+		median:           median,
+		sigma:            sigma,
+	}
+	return s
+}
+
+// WithChunkedDribbleDelay sets chunkedDribbleDelay and returns *StubRule
+func (s *StubRule) WithChunkedDribbleDelay(numberOfChunks int, totalDuration time.Duration) *StubRule {
+	s.response.chunkedDribbleDelay = &chunkedDribbleDelay{
+		NumberOfChunks: numberOfChunks,
+		TotalDuration:  totalDuration.Milliseconds(),
+	}
+	return s
+}
+
+// WillProxyTo sets proxyBaseURL and returns *StubRule
+func (s *StubRule) WillProxyTo(baseURL string) *StubRule {
+	s.response.proxyBaseURL = &baseURL
+	return s
+}
+
+// WithAdditionalRequestHeader adds a header to be sent on to the proxy target and returns *StubRule
+func (s *StubRule) WithAdditionalRequestHeader(name string, value string) *StubRule {
+	if s.response.additionalProxyRequestHeaders == nil {
+		s.response.additionalProxyRequestHeaders = map[string]string{}
+	}
+
+	s.response.additionalProxyRequestHeaders[name] = value
+	return s
+}
+
+// WithProxyURLPrefixToRemove sets proxyURLPrefixToRemove and returns *StubRule
+func (s *StubRule) WithProxyURLPrefixToRemove(prefix string) *StubRule {
+	s.response.proxyURLPrefixToRemove = &prefix
+	return s
+}
+
+// WithTransformers sets transformers and returns *StubRule
+func (s *StubRule) WithTransformers(names ...string) *StubRule {
+	s.response.transformers = append(s.response.transformers, names...)
+	return s
+}
+
+// WithTransformerParameter sets a transformer parameter and returns *StubRule
+func (s *StubRule) WithTransformerParameter(key string, value interface{}) *StubRule {
+	if s.response.transformerParameters == nil {
+		s.response.transformerParameters = map[string]interface{}{}
+	}
+
+	s.response.transformerParameters[key] = value
+	return s
+}
+
+// WithResponseTemplating enables the "response-template" transformer and returns *StubRule
+func (s *StubRule) WithResponseTemplating() *StubRule {
+	return s.WithTransformers("response-template")
+}
+
 // AtPriority sets priority and returns *StubRule
 func (s *StubRule) AtPriority(priority int64) *StubRule {
 	s.priority = &priority
@@ -154,18 +296,38 @@ func (s *StubRule) MarshalJSON() ([]byte, error) {
 		NewScenarioState              *string                `json:"newScenarioState,omitempty"`
 		Request                       map[string]interface{} `json:"request"`
 		Response                      struct {
-			Body    string            `json:"body,omitempty"`
-			Headers map[string]string `json:"headers,omitempty"`
-			Status  int64             `json:"status,omitempty"`
+			Body                          string                 `json:"body,omitempty"`
+			Headers                       map[string]string      `json:"headers,omitempty"`
+			Status                        int64                  `json:"status,omitempty"`
+			Fault                         *Fault                 `json:"fault,omitempty"`
+			FixedDelayMilliseconds        *int64                 `json:"fixedDelayMilliseconds,omitempty"`
+			DelayDistribution             *delayDistribution     `json:"delayDistribution,omitempty"`
+			ChunkedDribbleDelay           *chunkedDribbleDelay   `json:"chunkedDribbleDelay,omitempty"`
+			ProxyBaseURL                  *string                `json:"proxyBaseUrl,omitempty"`
+			AdditionalProxyRequestHeaders map[string]string      `json:"additionalProxyRequestHeaders,omitempty"`
+			ProxyURLPrefixToRemove        *string                `json:"proxyUrlPrefixToRemove,omitempty"`
+			Transformers                  []string               `json:"transformers,omitempty"`
+			TransformerParameters         map[string]interface{} `json:"transformerParameters,omitempty"`
 		} `json:"response"`
 	}{}
 	jsonStubRule.Priority = s.priority
 	jsonStubRule.ScenarioName = s.scenarioName
 	jsonStubRule.RequiredScenarioScenarioState = s.requiredScenarioState
 	jsonStubRule.NewScenarioState = s.newScenarioState
-	jsonStubRule.Response.Body = s.response.body
+	if s.response.proxyBaseURL == nil && s.response.fault == nil {
+		jsonStubRule.Response.Body = s.response.body
+		jsonStubRule.Response.Status = s.response.status
+	}
 	jsonStubRule.Response.Headers = s.response.headers
-	jsonStubRule.Response.Status = s.response.status
+	jsonStubRule.Response.Fault = s.response.fault
+	jsonStubRule.Response.FixedDelayMilliseconds = s.response.fixedDelayMilliseconds
+	jsonStubRule.Response.DelayDistribution = s.response.delayDistribution
+	jsonStubRule.Response.ChunkedDribbleDelay = s.response.chunkedDribbleDelay
+	jsonStubRule.Response.ProxyBaseURL = s.response.proxyBaseURL
+	jsonStubRule.Response.AdditionalProxyRequestHeaders = s.response.additionalProxyRequestHeaders
+	jsonStubRule.Response.ProxyURLPrefixToRemove = s.response.proxyURLPrefixToRemove
+	jsonStubRule.Response.Transformers = s.response.transformers
+	jsonStubRule.Response.TransformerParameters = s.response.transformerParameters
 	jsonStubRule.Request = mapFrom(&s.request)
 	return json.Marshal(jsonStubRule)
 }
@@ -183,40 +345,62 @@ func mapFrom(r *request) map[string]interface{} {
 		string(r.urlMatcher.Strategy()): r.urlMatcher.Value(),
 	}
 	if len(r.bodyPatterns) > 0 {
-		bodyPatterns := make([]map[ParamMatchingStrategy]string, len(r.bodyPatterns))
+		bodyPatterns := make([]interface{}, len(r.bodyPatterns))
 		for i, bodyPattern := range r.bodyPatterns {
-			bodyPatterns[i] = map[ParamMatchingStrategy]string{
-				bodyPattern.Strategy(): bodyPattern.Value(),
-			}
+			bodyPatterns[i] = matcherToMap(bodyPattern)
 		}
 		req["bodyPatterns"] = bodyPatterns
 	}
 	if len(r.headers) > 0 {
-		headers := make(map[string]map[ParamMatchingStrategy]string, len(r.bodyPatterns))
+		headers := make(map[string]interface{}, len(r.headers))
 		for key, header := range r.headers {
-			headers[key] = map[ParamMatchingStrategy]string{
-				header.Strategy(): header.Value(),
-			}
+			headers[key] = matcherToMap(header)
 		}
 		req["headers"] = headers
 	}
 	if len(r.cookies) > 0 {
-		cookies := make(map[string]map[ParamMatchingStrategy]string, len(r.cookies))
+		cookies := make(map[string]interface{}, len(r.cookies))
 		for key, cookie := range r.cookies {
-			cookies[key] = map[ParamMatchingStrategy]string{
-				cookie.Strategy(): cookie.Value(),
-			}
+			cookies[key] = matcherToMap(cookie)
 		}
 		req["cookies"] = cookies
 	}
 	if len(r.queryParams) > 0 {
-		params := make(map[string]map[ParamMatchingStrategy]string, len(r.queryParams))
+		params := make(map[string]interface{}, len(r.queryParams))
 		for key, param := range r.queryParams {
-			params[key] = map[ParamMatchingStrategy]string{
-				param.Strategy(): param.Value(),
-			}
+			params[key] = matcherToMap(param)
 		}
 		req["queryParameters"] = params
 	}
+	if len(r.multipartPatterns) > 0 {
+		req["multipartPatterns"] = r.multipartPatterns
+	}
 	return req
 }
+
+// matcherToMap serializes a ParamMatcherInterface to the shape WireMock expects: a flat
+// {strategy: value} map for a plain matcher, or {"and": [...]} / {"or": [...]} /
+// {"doesNotMatch": ...} for a composite matcher built with And/Or/Not.
+func matcherToMap(matcher ParamMatcherInterface) interface{} {
+	composite, ok := matcher.(compositeParamMatcher)
+	if !ok {
+		return map[ParamMatchingStrategy]string{matcher.Strategy(): matcher.Value()}
+	}
+
+	switch composite.strategy {
+	case ParamAnd:
+		return map[string]interface{}{"and": matchersToMaps(composite.matchers)}
+	case ParamOr:
+		return map[string]interface{}{"or": matchersToMaps(composite.matchers)}
+	default:
+		return map[string]interface{}{"doesNotMatch": matcherToMap(composite.matchers[0])}
+	}
+}
+
+func matchersToMaps(matchers []ParamMatcherInterface) []interface{} {
+	maps := make([]interface{}, len(matchers))
+	for i, matcher := range matchers {
+		maps[i] = matcherToMap(matcher)
+	}
+	return maps
+}
@@ -0,0 +1,77 @@
+package wiremock
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestStubRuleMarshalJSONFaultSuppressesBodyAndStatus(t *testing.T) {
+	stub := Get(URLPathEqualTo("/fault")).WillReturnFault(FaultConnectionResetByPeer)
+
+	data, err := json.Marshal(stub)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var decoded struct {
+		Response map[string]interface{} `json:"response"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, ok := decoded.Response["status"]; ok {
+		t.Errorf("expected status to be omitted for a fault response, got %v", decoded.Response)
+	}
+	if _, ok := decoded.Response["body"]; ok {
+		t.Errorf("expected body to be omitted for a fault response, got %v", decoded.Response)
+	}
+	if decoded.Response["fault"] != string(FaultConnectionResetByPeer) {
+		t.Errorf("expected fault to be %q, got %v", FaultConnectionResetByPeer, decoded.Response["fault"])
+	}
+}
+
+func TestStubRuleMarshalJSONDelayDistribution(t *testing.T) {
+	tests := []struct {
+		name      string
+		stub      *StubRule
+		wantField string
+		wantValue float64
+	}{
+		{
+			name:      "uniform",
+			stub:      Get(URLPathEqualTo("/delay")).WithUniformRandomDelay(10*time.Millisecond, 20*time.Millisecond),
+			wantField: "lower",
+			wantValue: 10,
+		},
+		{
+			name:      "lognormal",
+			stub:      Get(URLPathEqualTo("/delay")).WithLogNormalRandomDelay(90, 0.1),
+			wantField: "median",
+			wantValue: 90,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.stub)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var decoded struct {
+				Response struct {
+					DelayDistribution map[string]interface{} `json:"delayDistribution"`
+				} `json:"response"`
+			}
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if decoded.Response.DelayDistribution[tt.wantField] != tt.wantValue {
+				t.Errorf("expected %s to be %v, got %v", tt.wantField, tt.wantValue, decoded.Response.DelayDistribution[tt.wantField])
+			}
+		})
+	}
+}
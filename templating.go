@@ -0,0 +1,43 @@
+package wiremock
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MustParseTemplate runs a lightweight sanity check over a Handlebars-style response-template
+// body, catching unbalanced or empty "{{ }}" expressions at stub-registration time instead of
+// at request time. It does not fully validate Handlebars syntax (block helpers, partials, etc.);
+// it only checks that every "{{" has a matching "}}" and that no expression is empty.
+func MustParseTemplate(template string) error {
+	remaining := template
+	pos := 0
+
+	for {
+		start := strings.Index(remaining, "{{")
+		if start == -1 {
+			if strings.Contains(remaining, "}}") {
+				return fmt.Errorf("wiremock: unmatched \"}}\" in template at position %d", pos+strings.Index(remaining, "}}"))
+			}
+			return nil
+		}
+
+		if closeBefore := strings.Index(remaining[:start], "}}"); closeBefore != -1 {
+			return fmt.Errorf("wiremock: unmatched \"}}\" in template at position %d", pos+closeBefore)
+		}
+
+		end := strings.Index(remaining[start+2:], "}}")
+		if end == -1 {
+			return fmt.Errorf("wiremock: unterminated \"{{\" in template at position %d", pos+start)
+		}
+
+		expression := strings.TrimSpace(remaining[start+2 : start+2+end])
+		if expression == "" {
+			return fmt.Errorf("wiremock: empty expression \"{{}}\" in template at position %d", pos+start)
+		}
+
+		consumed := start + 2 + end + 2
+		pos += consumed
+		remaining = remaining[consumed:]
+	}
+}
@@ -0,0 +1,31 @@
+package wiremock
+
+import "testing"
+
+func TestMustParseTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantErr  bool
+	}{
+		{"no expressions", "plain text", false},
+		{"simple expression", "{{request.path.[0]}}", false},
+		{"multiple expressions", "{{request.path.[0]}} and {{request.query.name}}", false},
+		{"helper call", "{{jsonPath request.body '$.id'}}", false},
+		{"unterminated", "{{request.path.[0]", true},
+		{"empty expression", "{{}}", true},
+		{"stray close", "text}}more", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := MustParseTemplate(tt.template)
+			if tt.wantErr && err == nil {
+				t.Errorf("expected an error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}